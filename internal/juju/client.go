@@ -0,0 +1,65 @@
+// Package juju provides a thin client wrapping the Juju controller API
+// facades that the provider resources and data sources depend on.
+package juju
+
+import (
+	"context"
+
+	"github.com/juju/juju/api"
+)
+
+// ControllerConfiguration holds the information required to dial a Juju
+// controller.
+type ControllerConfiguration struct {
+	ControllerAddresses []string
+	Username            string
+	Password            string
+	CACert              string
+}
+
+// ConnectionFactory is implemented by anything that can open an API
+// connection to the configured controller. Sub-clients embed it so they can
+// obtain a facade connection without knowing how the controller was
+// configured.
+type ConnectionFactory interface {
+	GetConnection(ctx context.Context) (api.Connection, error)
+}
+
+// Client is the entry point used by the provider to talk to a Juju
+// controller. It exposes one sub-client per facade area.
+type Client struct {
+	Clouds      *cloudsClient
+	Credentials *credentialsClient
+
+	config ControllerConfiguration
+}
+
+// NewClient returns a Client configured to talk to the controller described
+// by config.
+func NewClient(config ControllerConfiguration) (*Client, error) {
+	sc := &sharedClient{config: config}
+
+	client := &Client{
+		Clouds:      newCloudsClient(sc),
+		Credentials: newCredentialsClient(sc, config.Username),
+		config:      config,
+	}
+	return client, nil
+}
+
+// sharedClient is the default ConnectionFactory implementation, dialing the
+// controller described by config on demand.
+type sharedClient struct {
+	config ControllerConfiguration
+}
+
+// GetConnection opens a new API connection to the configured controller.
+func (s *sharedClient) GetConnection(ctx context.Context) (api.Connection, error) {
+	info := &api.Info{
+		Addrs:    s.config.ControllerAddresses,
+		CACert:   s.config.CACert,
+		Password: s.config.Password,
+	}
+
+	return api.Open(info, api.DefaultDialOpts())
+}
@@ -0,0 +1,116 @@
+package juju
+
+import (
+	"context"
+	"fmt"
+
+	apicloud "github.com/juju/juju/api/client/cloud"
+	"github.com/juju/juju/environs"
+	"github.com/juju/names/v5"
+)
+
+type cloudsClient struct {
+	ConnectionFactory
+}
+
+func newCloudsClient(cf ConnectionFactory) *cloudsClient {
+	return &cloudsClient{
+		ConnectionFactory: cf,
+	}
+}
+
+// CredentialSchemaAttribute describes a single attribute of a credential
+// schema, as advertised by a cloud for a given auth type.
+type CredentialSchemaAttribute struct {
+	Optional bool
+}
+
+// CredentialSchemaResponse describes the credential schema of a cloud: the
+// auth types it supports and, per auth type, the attributes it accepts.
+type CredentialSchemaResponse struct {
+	AuthTypes  []string
+	Attributes map[string]map[string]CredentialSchemaAttribute
+	// Authoritative reports whether Attributes was sourced from the cloud's
+	// own provider and can be used to reject unknown/missing attributes. It
+	// is false when the provider exposes no credential schema, in which
+	// case Attributes is empty and must not be treated as "no attributes
+	// allowed".
+	Authoritative bool
+}
+
+// SupportsAuthType reports whether authType is one of the cloud's supported
+// auth types.
+func (r *CredentialSchemaResponse) SupportsAuthType(authType string) bool {
+	for _, at := range r.AuthTypes {
+		if at == authType {
+			return true
+		}
+	}
+	return false
+}
+
+// CredentialSchema returns the credential schema advertised by the named
+// cloud, so callers can validate auth_type and attributes before sending a
+// credential to the controller.
+func (c *cloudsClient) CredentialSchema(cloudName string) (*CredentialSchemaResponse, error) {
+	client, closeFn, err := c.getCloudAPIClient(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	defer closeFn()
+
+	cloudTag := names.NewCloudTag(cloudName)
+	jujuCloud, err := client.Cloud(cloudTag)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read schema for cloud %q: %w", cloudName, err)
+	}
+
+	authTypes := make([]string, 0, len(jujuCloud.AuthTypes))
+	for _, authType := range jujuCloud.AuthTypes {
+		authTypes = append(authTypes, string(authType))
+	}
+
+	// The credential schema (which attributes each auth type accepts) is
+	// defined per cloud provider, not globally, so it must be looked up
+	// against this specific cloud's provider rather than a flat table keyed
+	// only by auth type.
+	provider, err := environs.Provider(jujuCloud.Type)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find provider for cloud %q: %w", cloudName, err)
+	}
+
+	credentialProvider, ok := provider.(environs.ProviderCredentials)
+	if !ok {
+		// This provider doesn't advertise a credential schema; accept any
+		// attributes rather than wrongly rejecting all of them.
+		return &CredentialSchemaResponse{AuthTypes: authTypes}, nil
+	}
+
+	schemas := credentialProvider.CredentialSchemas()
+	attributes := make(map[string]map[string]CredentialSchemaAttribute, len(authTypes))
+	for _, authType := range jujuCloud.AuthTypes {
+		schema, ok := schemas[authType]
+		if !ok {
+			continue
+		}
+		attrs := make(map[string]CredentialSchemaAttribute, len(schema))
+		for _, attr := range schema {
+			attrs[attr.Name] = CredentialSchemaAttribute{Optional: attr.Optional}
+		}
+		attributes[string(authType)] = attrs
+	}
+
+	return &CredentialSchemaResponse{
+		AuthTypes:     authTypes,
+		Attributes:    attributes,
+		Authoritative: true,
+	}, nil
+}
+
+func (c *cloudsClient) getCloudAPIClient(ctx context.Context) (*apicloud.Client, func() error, error) {
+	conn, err := c.GetConnection(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return apicloud.NewClient(conn), conn.Close, nil
+}
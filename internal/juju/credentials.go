@@ -0,0 +1,224 @@
+package juju
+
+import (
+	"context"
+	"fmt"
+
+	apicloud "github.com/juju/juju/api/client/cloud"
+	"github.com/juju/juju/cloud"
+	"github.com/juju/names/v5"
+)
+
+type credentialsClient struct {
+	ConnectionFactory
+
+	// username is the controller user that owns client/controller
+	// credentials; Juju cloud credential tags are scoped to cloud/owner/name.
+	username string
+}
+
+func newCredentialsClient(cf ConnectionFactory, username string) *credentialsClient {
+	return &credentialsClient{
+		ConnectionFactory: cf,
+		username:          username,
+	}
+}
+
+type CreateCredentialInput struct {
+	Attributes           map[string]string
+	AuthType             string
+	ClientCredential     bool
+	CloudName            string
+	ControllerCredential bool
+	Name                 string
+	// Revoked marks the credential as unusable without deleting it.
+	Revoked bool
+}
+
+type CreateCredentialResponse struct {
+	CloudName string
+}
+
+type ReadCredentialInput struct {
+	ClientCredential     bool
+	CloudName            string
+	ControllerCredential bool
+	Name                 string
+	// IncludeSecrets, when true, requests that the controller return the
+	// credential's secret attribute values. Callers that expose the result
+	// as non-sensitive (e.g. a data source) must leave this false.
+	IncludeSecrets bool
+}
+
+type ReadCredentialResponse struct {
+	CloudCredential cloud.Credential
+}
+
+type UpdateCredentialInput struct {
+	Attributes           map[string]string
+	AuthType             string
+	ClientCredential     bool
+	CloudName            string
+	ControllerCredential bool
+	Name                 string
+	// Force, when true, allows the update to proceed even if it would
+	// invalidate a model that is currently using the credential.
+	Force bool
+	// Revoked marks the credential as unusable without deleting it.
+	Revoked bool
+}
+
+type DestroyCredentialInput struct {
+	ClientCredential     bool
+	CloudName            string
+	ControllerCredential bool
+	Name                 string
+	// Force, when true, allows the credential to be removed even if models
+	// are still using it, matching `juju remove-credential --force`.
+	Force bool
+}
+
+func (c *credentialsClient) getCloudAPIClient(ctx context.Context) (*apicloud.Client, func() error, error) {
+	conn, err := c.GetConnection(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return apicloud.NewClient(conn), conn.Close, nil
+}
+
+func (c *credentialsClient) CreateCredential(input CreateCredentialInput) (*CreateCredentialResponse, error) {
+	client, closeFn, err := c.getCloudAPIClient(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	defer closeFn()
+
+	cloudCredentialTag, err := cloudCredentialTagFromName(input.CloudName, c.username, input.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	credential := cloud.NewCredential(cloud.AuthType(input.AuthType), input.Attributes)
+	credential.Revoked = input.Revoked
+
+	if input.ClientCredential {
+		// TODO: persist the credential to the local client store.
+		_ = credential
+	}
+
+	if input.ControllerCredential {
+		if err := client.AddCredential(cloudCredentialTag.String(), credential); err != nil {
+			return nil, fmt.Errorf("unable to create credential %q: %w", input.Name, err)
+		}
+	}
+
+	return &CreateCredentialResponse{CloudName: input.CloudName}, nil
+}
+
+func (c *credentialsClient) ReadCredential(input ReadCredentialInput) (*ReadCredentialResponse, error) {
+	client, closeFn, err := c.getCloudAPIClient(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	defer closeFn()
+
+	cloudCredentialTag, err := cloudCredentialTagFromName(input.CloudName, c.username, input.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	contents, err := client.CredentialContents(input.CloudName, input.Name, input.IncludeSecrets)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read credential %q: %w", input.Name, err)
+	}
+	if len(contents) != 1 {
+		return nil, fmt.Errorf("expected 1 credential result for %q, got %d", input.Name, len(contents))
+	}
+	if contents[0].Error != nil {
+		return nil, fmt.Errorf("unable to read credential %q: %w", input.Name, contents[0].Error)
+	}
+
+	result := contents[0].Result.Content
+	attrs := make(map[string]string, len(result.Attributes))
+	for k, v := range result.Attributes {
+		attrs[k] = v
+	}
+
+	credential := cloud.NewCredential(cloud.AuthType(result.AuthType), attrs)
+	credential.Label = cloudCredentialTag.Name()
+	credential.Revoked = result.Revoked
+
+	return &ReadCredentialResponse{CloudCredential: credential}, nil
+}
+
+func (c *credentialsClient) UpdateCredential(input UpdateCredentialInput) error {
+	client, closeFn, err := c.getCloudAPIClient(context.Background())
+	if err != nil {
+		return err
+	}
+	defer closeFn()
+
+	cloudCredentialTag, err := cloudCredentialTagFromName(input.CloudName, c.username, input.Name)
+	if err != nil {
+		return err
+	}
+
+	credential := cloud.NewCredential(cloud.AuthType(input.AuthType), input.Attributes)
+	credential.Revoked = input.Revoked
+
+	if input.ControllerCredential {
+		// UpdateCredentialsCheckModels has no force parameter of its own: it
+		// always validates the credential against every model using it and
+		// reports per-model errors in the result. `juju update-credential
+		// --force` does not skip that validation on the controller side, it
+		// just doesn't fail the command over the reported errors, so mirror
+		// that here instead of passing a non-existent force argument.
+		results, err := client.UpdateCredentialsCheckModels(cloudCredentialTag, credential)
+		if err != nil {
+			return fmt.Errorf("unable to update credential %q: %w", input.Name, err)
+		}
+		if !input.Force {
+			for _, result := range results {
+				for _, errResult := range result.Errors {
+					if errResult.Error != nil {
+						return fmt.Errorf("unable to update credential %q: %w", input.Name, errResult.Error)
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c *credentialsClient) DestroyCredential(input DestroyCredentialInput) error {
+	client, closeFn, err := c.getCloudAPIClient(context.Background())
+	if err != nil {
+		return err
+	}
+	defer closeFn()
+
+	cloudCredentialTag, err := cloudCredentialTagFromName(input.CloudName, c.username, input.Name)
+	if err != nil {
+		return err
+	}
+
+	if input.ControllerCredential {
+		if err := client.RevokeCredential(cloudCredentialTag, input.Force); err != nil {
+			return fmt.Errorf("unable to destroy credential %q: %w", input.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// cloudCredentialTagFromName builds the cloud/owner/name id Juju uses to
+// identify a cloud credential and turns it into a CloudCredentialTag. owner
+// is the controller user the credential is scoped to.
+func cloudCredentialTagFromName(cloudName, owner, credentialName string) (names.CloudCredentialTag, error) {
+	id := fmt.Sprintf("%s/%s/%s", cloudName, owner, credentialName)
+	if !names.IsValidCloudCredential(id) {
+		return names.CloudCredentialTag{}, fmt.Errorf("unable to build credential tag for %q on cloud %q: invalid cloud credential id %q", credentialName, cloudName, id)
+	}
+	return names.NewCloudCredentialTag(id), nil
+}
@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &credentialDataSource{}
+var _ datasource.DataSourceWithConfigure = &credentialDataSource{}
+
+func NewCredentialDataSource() datasource.DataSource {
+	return &credentialDataSource{}
+}
+
+type credentialDataSource struct {
+	client *juju.Client
+}
+
+type credentialDataSourceModel struct {
+	Name                 types.String `tfsdk:"name"`
+	CloudName            types.String `tfsdk:"cloud_name"`
+	ClientCredential     types.Bool   `tfsdk:"client_credential"`
+	ControllerCredential types.Bool   `tfsdk:"controller_credential"`
+	AuthType             types.String `tfsdk:"auth_type"`
+	Attributes           types.Map    `tfsdk:"attributes"`
+
+	// ID required by the testing framework
+	ID types.String `tfsdk:"id"`
+}
+
+func (d *credentialDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_credential"
+}
+
+func (d *credentialDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A data source that represents an existing Juju credential for a cloud.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "The name of the credential",
+				Required:    true,
+			},
+			"cloud_name": schema.StringAttribute{
+				Description: "The name of the cloud the credential belongs to",
+				Required:    true,
+			},
+			"client_credential": schema.BoolAttribute{
+				Description: "Whether the credential is a client credential; recorded in the data source ID. " +
+					"The lookup always queries the controller, not the client.",
+				Optional: true,
+			},
+			"controller_credential": schema.BoolAttribute{
+				Description: "Whether the credential is a controller credential; recorded in the data source ID. " +
+					"The lookup queries the controller whatever this is set to.",
+				Optional: true,
+			},
+			"auth_type": schema.StringAttribute{
+				Description: "Credential authorization type",
+				Computed:    true,
+			},
+			"attributes": schema.MapAttribute{
+				Description: "Non-sensitive credential attributes",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+
+			// ID required by the testing framework
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *credentialDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data credentialDataSourceModel
+
+	// Read Terraform configuration from the request into the data model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Prevent a segfault if client is not yet configured
+	if d.client == nil {
+		resp.Diagnostics.AddError(
+			"Provider Error, Client Not Configured",
+			"Unable to read credential data source. Expected configured Juju Client. "+
+				"Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	credentialName := data.Name.ValueString()
+	cloudName := data.CloudName.ValueString()
+	clientCredential := data.ClientCredential.ValueBool()
+	controllerCredential := data.ControllerCredential.ValueBool()
+
+	response, err := d.client.Credentials.ReadCredential(juju.ReadCredentialInput{
+		ClientCredential:     clientCredential,
+		CloudName:            cloudName,
+		ControllerCredential: controllerCredential,
+		Name:                 credentialName,
+		// attributes exposed by this data source are documented as
+		// non-sensitive, so secret attribute values must never be requested.
+		IncludeSecrets: false,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read credential data source, got error: %s", err))
+		return
+	}
+	tflog.Trace(ctx, fmt.Sprintf("read credential data source %q", credentialName))
+
+	data.AuthType = types.StringValue(string(response.CloudCredential.AuthType()))
+
+	attributes := make(map[string]attr.Value)
+	for k, v := range response.CloudCredential.Attributes() {
+		attributes[k] = types.StringValue(attributeEntryToString(v))
+	}
+	attributesMap, errDiag := types.MapValueFrom(ctx, types.StringType, attributes)
+	resp.Diagnostics.Append(errDiag...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Attributes = attributesMap
+
+	data.ID = types.StringValue(newIDFrom(credentialName, cloudName, clientCredential, controllerCredential))
+
+	// Write the data into the Response.State
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *credentialDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*juju.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *juju.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
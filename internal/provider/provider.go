@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+// Ensure jujuProvider fully satisfies framework interfaces.
+var _ provider.Provider = &jujuProvider{}
+
+func New() provider.Provider {
+	return &jujuProvider{}
+}
+
+type jujuProvider struct{}
+
+type jujuProviderModel struct {
+	ControllerAddresses types.String `tfsdk:"controller_addresses"`
+	Username            types.String `tfsdk:"username"`
+	Password            types.String `tfsdk:"password"`
+	CACert              types.String `tfsdk:"ca_certificate"`
+}
+
+func (p *jujuProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "juju"
+}
+
+func (p *jujuProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Interact with a Juju controller.",
+		Attributes: map[string]schema.Attribute{
+			"controller_addresses": schema.StringAttribute{
+				Description: "Comma separated list of Juju controller addresses",
+				Optional:    true,
+			},
+			"username": schema.StringAttribute{
+				Description: "The username to authenticate with the controller",
+				Optional:    true,
+			},
+			"password": schema.StringAttribute{
+				Description: "The password to authenticate with the controller",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"ca_certificate": schema.StringAttribute{
+				Description: "The CA certificate to use when connecting to the controller",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (p *jujuProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	var data jujuProviderModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := juju.NewClient(juju.ControllerConfiguration{
+		ControllerAddresses: strings.Split(data.ControllerAddresses.ValueString(), ","),
+		Username:            data.Username.ValueString(),
+		Password:            data.Password.ValueString(),
+		CACert:              data.CACert.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable To Configure Client", err.Error())
+		return
+	}
+
+	resp.ResourceData = client
+	resp.DataSourceData = client
+}
+
+func (p *jujuProvider) Resources(_ context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		NewCredentialResource,
+	}
+}
+
+func (p *jujuProvider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return []func() datasource.DataSource{
+		NewCredentialDataSource,
+	}
+}
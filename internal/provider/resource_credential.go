@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"os"
 	"strconv"
 	"strings"
 
@@ -26,6 +27,7 @@ import (
 var _ resource.Resource = &credentialResource{}
 var _ resource.ResourceWithConfigure = &credentialResource{}
 var _ resource.ResourceWithImportState = &credentialResource{}
+var _ resource.ResourceWithValidateConfig = &credentialResource{}
 
 func NewCredentialResource() resource.Resource {
 	return &credentialResource{}
@@ -38,10 +40,13 @@ type credentialResource struct {
 type credentialResourceModel struct {
 	Cloud                types.Object `tfsdk:"cloud"`
 	Attributes           types.Map    `tfsdk:"attributes"`
+	AttributesFromFile   types.Map    `tfsdk:"attributes_from_file"`
 	AuthType             types.String `tfsdk:"auth_type"`
 	ClientCredential     types.Bool   `tfsdk:"client_credential"`
 	ControllerCredential types.Bool   `tfsdk:"controller_credential"`
+	Force                types.Bool   `tfsdk:"force"`
 	Name                 types.String `tfsdk:"name"`
+	Revoked              types.Bool   `tfsdk:"revoked"`
 
 	// ID required by the testing framework
 	ID types.String `tfsdk:"id"`
@@ -74,6 +79,14 @@ func (c *credentialResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"attributes_from_file": schema.MapAttribute{
+				Description: "Credential attributes whose values are read from local files, keyed by attribute " +
+					"name (e.g. a service account `key` read from a JSON key file). A key may not be set in both " +
+					"`attributes` and `attributes_from_file`. File contents are write-only and are not re-read on " +
+					"refresh.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
 			"auth_type": schema.StringAttribute{
 				Description: "Credential authorization type",
 				Required:    true,
@@ -90,6 +103,13 @@ func (c *credentialResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Computed:    true,
 				Default:     booldefault.StaticBool(true),
 			},
+			"force": schema.BoolAttribute{
+				Description: "Force the update or destruction of the credential even if it is still bound to a model. " +
+					"This matches the `--force` flag of `juju update-credential` and `juju remove-credential`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
 			"name": schema.StringAttribute{
 				Description: "The name to be assigned to the credential",
 				Required:    true,
@@ -97,6 +117,13 @@ func (c *credentialResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"revoked": schema.BoolAttribute{
+				Description: "Whether the credential is revoked. A revoked credential cannot be used but is not " +
+					"deleted, so model bindings referencing it are preserved.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
 
 			// ID required by the testing framework
 			"id": schema.StringAttribute{
@@ -119,8 +146,12 @@ func (c *credentialResource) Create(ctx context.Context, req resource.CreateRequ
 	}
 
 	// Access the fields
-	// attributes
+	// attributes, merged with any attributes_from_file entries
 	attributes := convertRawAttributes(data.Attributes.Elements())
+	resp.Diagnostics.Append(mergeAttributesFromFile(attributes, data.AttributesFromFile)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// auth_type
 	authType := data.AuthType.ValueString()
@@ -138,6 +169,9 @@ func (c *credentialResource) Create(ctx context.Context, req resource.CreateRequ
 	// name
 	credentialName := data.Name.ValueString()
 
+	// revoked
+	revoked := data.Revoked.ValueBool()
+
 	// Prevent a segfault if client is not yet configured
 	if c.client == nil {
 		resp.Diagnostics.AddError(
@@ -156,6 +190,7 @@ func (c *credentialResource) Create(ctx context.Context, req resource.CreateRequ
 		CloudName:            cloudName,
 		ControllerCredential: controllerCredential,
 		Name:                 credentialName,
+		Revoked:              revoked,
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create credential resource, got error: %s", err))
@@ -225,6 +260,7 @@ func (c *credentialResource) Read(ctx context.Context, req resource.ReadRequest,
 		CloudName:            cloudName,
 		ControllerCredential: controllerCredential,
 		Name:                 credentialName,
+		IncludeSecrets:       true,
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read credential resource, got error: %s", err))
@@ -235,6 +271,7 @@ func (c *credentialResource) Read(ctx context.Context, req resource.ReadRequest,
 	// retrieve name & auth_type
 	data.Name = types.StringValue(response.CloudCredential.Label)
 	data.AuthType = types.StringValue(string(response.CloudCredential.AuthType()))
+	data.Revoked = types.BoolValue(response.CloudCredential.Revoked)
 
 	// retrieve the attributes
 	receivedAttributes := response.CloudCredential.Attributes()
@@ -283,7 +320,10 @@ func (c *credentialResource) Update(ctx context.Context, req resource.UpdateRequ
 	if data.AuthType.Equal(state.AuthType) &&
 		data.ClientCredential.Equal(state.ClientCredential) &&
 		data.ControllerCredential.Equal(state.ControllerCredential) &&
-		data.Attributes.Equal(state.Attributes) {
+		data.Force.Equal(state.Force) &&
+		data.Revoked.Equal(state.Revoked) &&
+		data.Attributes.Equal(state.Attributes) &&
+		data.AttributesFromFile.Equal(state.AttributesFromFile) {
 		return
 	}
 
@@ -304,8 +344,18 @@ func (c *credentialResource) Update(ctx context.Context, req resource.UpdateRequ
 	newClientCredential := data.ClientCredential.ValueBool()
 	newControllerCredential := data.ControllerCredential.ValueBool()
 
-	// attributes
+	// attributes, merged with any attributes_from_file entries
 	newAttributes := convertRawAttributes(data.Attributes.Elements())
+	resp.Diagnostics.Append(mergeAttributesFromFile(newAttributes, data.AttributesFromFile)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// force
+	newForce := data.Force.ValueBool()
+
+	// revoked
+	newRevoked := data.Revoked.ValueBool()
 
 	// Prevent runtime to freak out if client is not configured
 	if c.client == nil {
@@ -324,7 +374,9 @@ func (c *credentialResource) Update(ctx context.Context, req resource.UpdateRequ
 		ClientCredential:     newClientCredential,
 		CloudName:            cloudName,
 		ControllerCredential: newControllerCredential,
+		Force:                newForce,
 		Name:                 credentialName,
+		Revoked:              newRevoked,
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update credential resource, got error: %s", err))
@@ -376,6 +428,7 @@ func (c *credentialResource) Delete(ctx context.Context, req resource.DeleteRequ
 		ClientCredential:     clientCredential,
 		CloudName:            cloudName,
 		ControllerCredential: controllerCredential,
+		Force:                data.Force.ValueBool(),
 		Name:                 credentialName,
 	})
 	if err != nil {
@@ -401,6 +454,110 @@ func (c *credentialResource) Configure(ctx context.Context, req resource.Configu
 	c.client = client
 }
 
+// ValidateConfig checks auth_type and attributes against the credential
+// schema advertised by the target cloud, turning controller-side "invalid
+// credential" rejections into plan-time errors.
+func (c *credentialResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *credentialResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The schema can only be looked up once the cloud and auth_type are
+	// known, and once the client is configured.
+	if c.client == nil || data.Cloud.IsUnknown() || data.Cloud.IsNull() || data.AuthType.IsUnknown() {
+		return
+	}
+
+	cloudAttributes := data.Cloud.Attributes()
+	cloudNameValue, ok := cloudAttributes["name"].(basetypes.StringValue)
+	if !ok || cloudNameValue.IsUnknown() || cloudNameValue.IsNull() {
+		return
+	}
+	cloudName := cloudNameValue.ValueString()
+	authType := data.AuthType.ValueString()
+
+	credentialSchema, err := c.client.Clouds.CredentialSchema(cloudName)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to validate credential resource, got error: %s", err))
+		return
+	}
+
+	if !credentialSchema.SupportsAuthType(authType) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("auth_type"),
+			"Invalid Credential Auth Type",
+			fmt.Sprintf("Cloud %q does not support auth type %q, supported auth types are: %v", cloudName, authType, credentialSchema.AuthTypes),
+		)
+		return
+	}
+
+	if data.Attributes.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateCredentialAttributes(
+		credentialSchema, authType, cloudName, data.Attributes.Elements(), data.AttributesFromFile.Elements(),
+	)...)
+}
+
+// validateCredentialAttributes checks configuredAttributes against the
+// required/known attributes of authType in credentialSchema, skipping an
+// attribute that is instead satisfied via attributes_from_file
+// (fileAttributes). It only reports diagnostics when credentialSchema is
+// authoritative for authType: an absent or non-authoritative schema means
+// the controller's own attribute set cannot be reliably second-guessed, so
+// nothing is flagged rather than rejecting a config the controller would
+// accept.
+func validateCredentialAttributes(
+	credentialSchema *juju.CredentialSchemaResponse,
+	authType string,
+	cloudName string,
+	configuredAttributes map[string]attr.Value,
+	fileAttributes map[string]attr.Value,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !credentialSchema.Authoritative {
+		return diags
+	}
+
+	schemaAttributes, ok := credentialSchema.Attributes[authType]
+	if !ok {
+		return diags
+	}
+
+	for name, attr := range schemaAttributes {
+		if attr.Optional {
+			continue
+		}
+		if _, exists := configuredAttributes[name]; !exists {
+			if _, fromFile := fileAttributes[name]; fromFile {
+				continue
+			}
+			diags.AddAttributeError(
+				path.Root("attributes"),
+				"Missing Required Credential Attribute",
+				fmt.Sprintf("Auth type %q on cloud %q requires attribute %q", authType, cloudName, name),
+			)
+		}
+	}
+
+	for name := range configuredAttributes {
+		if _, known := schemaAttributes[name]; !known {
+			diags.AddAttributeError(
+				path.Root("attributes").AtMapKey(name),
+				"Unknown Credential Attribute",
+				fmt.Sprintf("Attribute %q is not supported by auth type %q on cloud %q", name, authType, cloudName),
+			)
+		}
+	}
+
+	return diags
+}
+
 func (c credentialResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
@@ -413,6 +570,51 @@ func convertRawAttributes(attributesRaw map[string]attr.Value) map[string]string
 	return newAttributes
 }
 
+// mergeAttributesFromFile resolves each (attribute name, file path) pair in
+// attributesFromFile, reads the file content and places it into attributes
+// under the same key. It mirrors Juju's FinalizeCredential behaviour, where
+// a `*-file` attribute is expanded into its corresponding attribute before
+// the credential is uploaded to the controller.
+func mergeAttributesFromFile(attributes map[string]string, attributesFromFile types.Map) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for key, rawPath := range attributesFromFile.Elements() {
+		if _, exists := attributes[key]; exists {
+			diags.AddAttributeError(
+				path.Root("attributes_from_file").AtMapKey(key),
+				"Conflicting Credential Attribute",
+				fmt.Sprintf("%q is set in both `attributes` and `attributes_from_file`; set it in only one of the two.", key),
+			)
+			continue
+		}
+
+		pathValue, ok := rawPath.(types.String)
+		if !ok {
+			diags.AddAttributeError(
+				path.Root("attributes_from_file").AtMapKey(key),
+				"Invalid Credential Attribute File Path",
+				fmt.Sprintf("Expected a string path for attribute %q, got: %T", key, rawPath),
+			)
+			continue
+		}
+
+		filePath := pathValue.ValueString()
+		content, err := os.ReadFile(filePath)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("attributes_from_file").AtMapKey(key),
+				"Unable To Read Credential Attribute File",
+				fmt.Sprintf("Unable to read file %q for attribute %q: %s", filePath, key, err),
+			)
+			continue
+		}
+
+		attributes[key] = string(content)
+	}
+
+	return diags
+}
+
 func newIDFrom(credentialName string, cloudName string, clientCredential bool, controllerCredential bool) string {
 	return fmt.Sprintf("%s:%s:%t:%t", credentialName, cloudName, clientCredential, controllerCredential)
 }
@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/juju/terraform-provider-juju/internal/juju"
+)
+
+func TestMergeAttributesFromFile(t *testing.T) {
+	dir := t.TempDir()
+	keyFile := filepath.Join(dir, "key.json")
+	if err := os.WriteFile(keyFile, []byte("super-secret"), 0o600); err != nil {
+		t.Fatalf("failed to write test fixture: %v", err)
+	}
+
+	tests := []struct {
+		name               string
+		attributes         map[string]string
+		attributesFromFile map[string]attr.Value
+		wantAttributes     map[string]string
+		wantErr            bool
+	}{
+		{
+			name:       "reads file contents into attributes",
+			attributes: map[string]string{},
+			attributesFromFile: map[string]attr.Value{
+				"key": types.StringValue(keyFile),
+			},
+			wantAttributes: map[string]string{"key": "super-secret"},
+		},
+		{
+			name:       "conflicting key between attributes and attributes_from_file errors",
+			attributes: map[string]string{"key": "inline-value"},
+			attributesFromFile: map[string]attr.Value{
+				"key": types.StringValue(keyFile),
+			},
+			wantErr: true,
+		},
+		{
+			name:       "missing file errors",
+			attributes: map[string]string{},
+			attributesFromFile: map[string]attr.Value{
+				"key": types.StringValue(filepath.Join(dir, "does-not-exist.json")),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attributesFromFile, diags := types.MapValue(types.StringType, tt.attributesFromFile)
+			if diags.HasError() {
+				t.Fatalf("failed to build attributes_from_file map: %v", diags)
+			}
+
+			attributes := make(map[string]string, len(tt.attributes))
+			for k, v := range tt.attributes {
+				attributes[k] = v
+			}
+
+			gotDiags := mergeAttributesFromFile(attributes, attributesFromFile)
+
+			if tt.wantErr {
+				if !gotDiags.HasError() {
+					t.Fatalf("expected an error, got none")
+				}
+				return
+			}
+			if gotDiags.HasError() {
+				t.Fatalf("unexpected error: %v", gotDiags)
+			}
+
+			for k, want := range tt.wantAttributes {
+				if got := attributes[k]; got != want {
+					t.Errorf("attributes[%q] = %q, want %q", k, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateCredentialAttributes(t *testing.T) {
+	authoritativeSchema := &juju.CredentialSchemaResponse{
+		AuthTypes: []string{"access-key"},
+		Attributes: map[string]map[string]juju.CredentialSchemaAttribute{
+			"access-key": {
+				"access-key": {},
+				"secret-key": {},
+				"region":     {Optional: true},
+			},
+		},
+		Authoritative: true,
+	}
+
+	tests := []struct {
+		name           string
+		schema         *juju.CredentialSchemaResponse
+		authType       string
+		configured     map[string]attr.Value
+		fileAttributes map[string]attr.Value
+		wantErr        bool
+	}{
+		{
+			name:     "all required attributes present",
+			schema:   authoritativeSchema,
+			authType: "access-key",
+			configured: map[string]attr.Value{
+				"access-key": types.StringValue("AKIA..."),
+				"secret-key": types.StringValue("shh"),
+			},
+		},
+		{
+			name:     "required attribute satisfied from file",
+			schema:   authoritativeSchema,
+			authType: "access-key",
+			configured: map[string]attr.Value{
+				"access-key": types.StringValue("AKIA..."),
+			},
+			fileAttributes: map[string]attr.Value{
+				"secret-key": types.StringValue("/tmp/secret-key"),
+			},
+		},
+		{
+			name:     "missing required attribute",
+			schema:   authoritativeSchema,
+			authType: "access-key",
+			configured: map[string]attr.Value{
+				"access-key": types.StringValue("AKIA..."),
+			},
+			wantErr: true,
+		},
+		{
+			name:     "unknown attribute",
+			schema:   authoritativeSchema,
+			authType: "access-key",
+			configured: map[string]attr.Value{
+				"access-key": types.StringValue("AKIA..."),
+				"secret-key": types.StringValue("shh"),
+				"bogus":      types.StringValue("nope"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "non-authoritative schema never errors",
+			schema: &juju.CredentialSchemaResponse{
+				AuthTypes: []string{"access-key"},
+			},
+			authType: "access-key",
+			configured: map[string]attr.Value{
+				"bogus": types.StringValue("nope"),
+			},
+		},
+		{
+			name:     "auth type missing from the authoritative schema never errors",
+			schema:   authoritativeSchema,
+			authType: "jsonfile",
+			configured: map[string]attr.Value{
+				"anything": types.StringValue("goes"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateCredentialAttributes(tt.schema, tt.authType, "aws", tt.configured, tt.fileAttributes)
+			if tt.wantErr != diags.HasError() {
+				t.Fatalf("HasError() = %v, want %v (diags: %v)", diags.HasError(), tt.wantErr, diags)
+			}
+		})
+	}
+}